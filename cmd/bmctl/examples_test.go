@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/GSI-HPC/bmctl/pkg/cli"
+	_testing "github.com/GSI-HPC/bmctl/pkg/testing"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func walkCommands(cmd *cobra.Command, fn func(*cobra.Command)) {
+	fn(cmd)
+	for _, child := range cmd.Commands() {
+		walkCommands(child, fn)
+	}
+}
+
+func Test_CommandsHaveExamples(t *testing.T) {
+	walkCommands(newApp(), func(cmd *cobra.Command) {
+		if cmd.Runnable() && cmd.HasParent() {
+			assert.NotEmpty(t, cli.Examples(cmd), "%q has no examples", cmd.CommandPath())
+		}
+	})
+}
+
+func Test_Examples(t *testing.T) {
+	walkCommands(newApp(), func(cmd *cobra.Command) {
+		for _, ex := range cli.Examples(cmd) {
+			if !ex.Runnable() {
+				continue
+			}
+			t.Run(ex.Comment, func(t *testing.T) {
+				require.Equal(t, "bmctl", ex.Args[0])
+				app := newApp()
+				app.SetArgs(ex.Args[1:])
+				getStdout := _testing.Capture(os.Stdout)
+				exit := cli.Execute(context.Background(), app)
+				stdout := getStdout()
+				require.Equal(t, cli.EXIT_SUCCESS, exit)
+				assert.Regexp(t, ex.Output, stdout)
+			})
+		}
+	})
+}
@@ -5,15 +5,24 @@
 package main
 
 import (
+	"context"
 	"log/slog"
 	"os"
 
 	"github.com/GSI-HPC/bmctl/pkg/cli"
+	"github.com/GSI-HPC/bmctl/pkg/i18n"
 	_logging "github.com/GSI-HPC/bmctl/pkg/logging"
 	"github.com/spf13/cobra"
 )
 
-var showDebug = false
+var (
+	showDebug      = false
+	outputFormat   = cli.OutputText
+	formatTemplate = ""
+	wideOutput     = false
+	language       = i18n.DefaultLanguage
+	timeFormat     = cli.TimeLocal
+)
 
 func logLevel() slog.Level {
 	if showDebug {
@@ -22,34 +31,82 @@ func logLevel() slog.Level {
 	return slog.LevelInfo
 }
 
-func setupLogging(cmd *cobra.Command, args []string) {
+func setupLogging(ctx context.Context) context.Context {
 	opts := &slog.HandlerOptions{Level: logLevel()}
 	handler := slog.NewTextHandler(os.Stderr, opts)
 	logger := slog.New(handler)
-	ctx := _logging.WithLogger(cmd.Context(), logger)
-	parent := cmd
-	for parent != nil {
+	return _logging.WithLogger(ctx, logger)
+}
+
+// setupOutput adds a renderer for the selected output format to the context,
+// unless the caller already provided one.
+func setupOutput(ctx context.Context) (context.Context, error) {
+	if cli.HasRenderer(ctx) {
+		return ctx, nil
+	}
+	renderer, err := cli.NewRenderer(os.Stdout, cli.RenderOptions{
+		Format:     outputFormat,
+		Template:   formatTemplate,
+		Wide:       wideOutput,
+		Width:      cli.TerminalWidth(os.Stdout),
+		TimeFormat: timeFormat,
+	})
+	if err != nil {
+		return ctx, err
+	}
+	return cli.WithRenderer(ctx, renderer), nil
+}
+
+// setCommandContext sets ctx on cmd and all its parents, so that
+// cli.Execute sees it on the root command as well.
+func setCommandContext(cmd *cobra.Command, ctx context.Context) {
+	for parent := cmd; parent != nil; parent = parent.Parent() {
 		parent.SetContext(ctx)
-		parent = parent.Parent()
 	}
 }
 
+func setupContext(cmd *cobra.Command, args []string) error {
+	ctx := setupLogging(cmd.Context())
+	// Errors from the remaining setup are logged with the configured logger.
+	setCommandContext(cmd, ctx)
+	ctx, err := setupOutput(ctx)
+	if err != nil {
+		return err
+	}
+	lang, err := i18n.ParseLanguage(language)
+	if err != nil {
+		return err
+	}
+	ctx = i18n.WithLanguage(ctx, lang)
+	setCommandContext(cmd, ctx)
+	return nil
+}
+
 func newRootCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:              "bmctl",
-		Short:            "Out-of-band datacenter device management via the BMC interface",
-		Long:             ``,
-		PersistentPreRun: setupLogging,
+		Use:               "bmctl",
+		Short:             "Out-of-band datacenter device management via the BMC interface",
+		Long:              ``,
+		PersistentPreRunE: setupContext,
 	}
 	cmd.PersistentFlags().BoolVarP(&showDebug, "debug", "d", false, "show debug logs")
+	cmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", cli.OutputText, "output format (text, json, table, brief)")
+	cmd.PersistentFlags().StringVar(&formatTemplate, "format", "", "render results with a Go template, e.g. '{{.Version}}'")
+	cmd.PersistentFlags().BoolVar(&wideOutput, "wide", false, "show all table columns")
+	cmd.PersistentFlags().StringVar(&timeFormat, "time-format", cli.TimeLocal, "timestamps in table and brief output (local, utc, relative)")
+	cmd.PersistentFlags().StringVar(&language, "lang", i18n.DefaultLanguage, "language of prompts and summaries (de, en)")
+	cmd.MarkFlagsMutuallyExclusive("output", "format")
 	return cmd
 }
 
-func main() {
-	ctx := cli.SignalContext()
-
+// newApp returns the root command with all subcommands attached.
+func newApp() *cobra.Command {
 	rootCmd := newRootCmd()
 	rootCmd.AddCommand(newVersionCmd())
+	return rootCmd
+}
 
-	os.Exit(cli.Execute(ctx, rootCmd))
+func main() {
+	ctx := cli.SignalContext()
+	os.Exit(cli.Execute(ctx, newApp()))
 }
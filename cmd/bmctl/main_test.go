@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/GSI-HPC/bmctl/pkg/cli"
+	_testing "github.com/GSI-HPC/bmctl/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GuardFlagsConsistent(t *testing.T) {
+	assert.NoError(t, cli.CheckGuardFlags(newApp()))
+}
+
+func Test_SetupErrorsUseConfiguredLogger(t *testing.T) {
+	for _, args := range [][]string{
+		{"version", "--output", "bogus"},
+		{"version", "--lang", "xx"},
+	} {
+		app := newApp()
+		app.SetArgs(args)
+		getStderr := _testing.Capture(os.Stderr)
+		exit := cli.Execute(context.Background(), app)
+		stderr := getStderr()
+		assert.Equal(t, cli.EXIT_FAILURE, exit)
+		assert.Regexp(t, `^time=\S+ level=ERROR msg=`, stderr, args)
+	}
+}
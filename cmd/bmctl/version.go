@@ -6,12 +6,33 @@ package main
 
 import (
 	"errors"
-	"os"
+	"regexp"
 	"runtime/debug"
 
+	"github.com/GSI-HPC/bmctl/pkg/cli"
 	"github.com/spf13/cobra"
 )
 
+type versionInfo struct {
+	Version string `json:"version"`
+}
+
+func (v versionInfo) String() string {
+	return v.Version
+}
+
+func (v versionInfo) BriefFields() []cli.Field {
+	return []cli.Field{{Name: "version", Value: v.Version}}
+}
+
+func (v versionInfo) Columns() []cli.Column {
+	return []cli.Column{{Header: "VERSION", Key: true}}
+}
+
+func (v versionInfo) Rows() [][]any {
+	return [][]any{{v.Version}}
+}
+
 func newVersionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "version",
@@ -19,15 +40,26 @@ func newVersionCmd() *cobra.Command {
 		Long:  ``,
 		RunE:  version,
 	}
+	cli.SetExamples(cmd,
+		cli.Example{
+			Comment: "Print the version of this build",
+			Args:    []string{"bmctl", "version"},
+			Output:  regexp.MustCompile(`^(\(devel\)|v[0-9]+\.[0-9]+\.[0-9]+\S*)\n$`),
+		},
+		cli.Example{
+			Comment: "Print the version as JSON",
+			Args:    []string{"bmctl", "version", "--output", "json"},
+			Output:  regexp.MustCompile(`"version": ".+"`),
+		},
+	)
 	return cmd
 }
 
 func version(cmd *cobra.Command, args []string) error {
-	cmd.SetOut(os.Stdout)
 	info, ok := debug.ReadBuildInfo()
 	if !ok {
 		return errors.New("could not read embedded build info ('go build -buildvcs=true')")
 	}
-	cmd.Println(info.Main.Version)
-	return nil
+	renderer := cli.RendererFromContext(cmd.Context())
+	return renderer.Render(versionInfo{Version: info.Main.Version})
 }
@@ -5,10 +5,12 @@
 package main
 
 import (
+	"context"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/GSI-HPC/bmctl/pkg/cli"
 	_testing "github.com/GSI-HPC/bmctl/pkg/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,3 +23,12 @@ func Test_versionCmd(t *testing.T) {
 	require.NoError(t, err)
 	assert.Regexp(t, regexp.MustCompile(`(\(devel\))|(v[0-9]+\.[0-9]+\.[0-9]+)`), getStdout())
 }
+
+func Test_versionCmdJSON(t *testing.T) {
+	buf, err := cli.NewBufferRenderer(cli.RenderOptions{Format: cli.OutputJSON})
+	require.NoError(t, err)
+	cmd := newVersionCmd()
+	err = cmd.ExecuteContext(cli.WithRenderer(context.Background(), buf))
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^\{\n  "version": ".+"\n\}\n$`), buf.String())
+}
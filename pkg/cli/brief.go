@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Brief is implemented by results that can be rendered with OutputBrief.
+type Brief interface {
+	BriefFields() []Field // BriefFields returns the fields in a stable order.
+}
+
+// Field is a named value on a brief status line.
+type Field struct {
+	Name  string
+	Value any
+}
+
+// ErrorRenderer is implemented by renderers that present command failures
+// on their own instead of leaving them to the logger.
+type ErrorRenderer interface {
+	RenderError(err error) error
+}
+
+// briefRenderer prints exactly one line per result or failure, like
+// "bmc17 OK power=On health=OK" or "bmc17 ERROR timeout: ...", meant for
+// grep, diff and cron mails. There are no headers and no colors.
+// The target prefix is left out if RenderOptions.Target is empty, which is
+// always the case in bmctl until commands can select a BMC endpoint.
+type briefRenderer struct {
+	w      io.Writer
+	target string
+	times  timeFormatter
+}
+
+func (r *briefRenderer) Render(v any) error {
+	b, ok := v.(Brief)
+	if !ok {
+		return fmt.Errorf("%T cannot be rendered in brief format", v)
+	}
+	parts := []string{"OK"}
+	for _, f := range b.BriefFields() {
+		parts = append(parts, f.Name+"="+briefValue(r.times.format(f.Value)))
+	}
+	return r.println(parts)
+}
+
+func (r *briefRenderer) RenderError(err error) error {
+	class, msg := errorClass(err), oneLine(err.Error())
+	// Avoid "interrupted: interrupted: ..." for errors named after their class.
+	if msg == class {
+		return r.println([]string{"ERROR", class})
+	}
+	msg = strings.TrimPrefix(msg, class+": ")
+	return r.println([]string{"ERROR", class + ":", msg})
+}
+
+func (r *briefRenderer) println(parts []string) error {
+	if r.target != "" {
+		parts = append([]string{r.target}, parts...)
+	}
+	_, err := fmt.Fprintln(r.w, strings.Join(parts, " "))
+	return err
+}
+
+// briefValue quotes values that would otherwise break the field syntax.
+func briefValue(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// oneLine joins the lines of a multi-line message, e.g. from errors.Join.
+func oneLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "; ")
+}
+
+// errorClass returns a short category of err. Errors can provide their own
+// by implementing a Class() string method.
+func errorClass(err error) string {
+	var classified interface{ Class() string }
+	switch {
+	case errors.As(err, &classified):
+		return classified.Class()
+	case errors.Is(err, ErrInterrupted):
+		return "interrupted"
+	case errors.Is(err, ErrAborted):
+		return "aborted"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+	return "error"
+}
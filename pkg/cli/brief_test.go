@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// assertGolden compares output with testdata/name, or rewrites the file
+// when the tests run with -update.
+func assertGolden(t *testing.T, name string, output string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(path, []byte(output), 0o644))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), output)
+}
+
+type testStatus struct {
+	Power  string
+	Health string
+	Temp   int
+	Model  string
+}
+
+func (s testStatus) BriefFields() []Field {
+	return []Field{
+		{"power", s.Power},
+		{"health", s.Health},
+		{"temp_max", fmt.Sprintf("%dC", s.Temp)},
+		{"model", s.Model},
+	}
+}
+
+type classifiedError struct{}
+
+func (classifiedError) Error() string { return "401 Unauthorized" }
+func (classifiedError) Class() string { return "auth" }
+
+func Test_BriefRenderer(t *testing.T) {
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputBrief, Target: "bmc17"})
+	require.NoError(t, err)
+	require.NoError(t, buf.Render(testStatus{"On", "OK", 61, "PowerEdge"}))
+	require.NoError(t, buf.Render(testStatus{"Off", "Critical", 98, "ProLiant DL360 Gen10"}))
+	require.NoError(t, buf.Render(testStatus{}))
+	require.NoError(t, buf.RenderError(errors.New("connection refused")))
+	require.NoError(t, buf.RenderError(classifiedError{}))
+	require.NoError(t, buf.RenderError(fmt.Errorf("reading sensors: %w", context.DeadlineExceeded)))
+	require.NoError(t, buf.RenderError(errors.Join(errors.New("chassis 1 failed"), errors.New("chassis 2 failed"))))
+	require.NoError(t, buf.RenderError(fmt.Errorf("%w: received SIGINT", ErrInterrupted)))
+	assertGolden(t, "brief/target.golden", buf.String())
+}
+
+func Test_BriefRenderer_NoTarget(t *testing.T) {
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputBrief})
+	require.NoError(t, err)
+	require.NoError(t, buf.Render(testStatus{"On", "OK", 61, "PowerEdge"}))
+	require.NoError(t, buf.RenderError(ErrAborted))
+	assertGolden(t, "brief/no-target.golden", buf.String())
+}
+
+func Test_BriefRenderer_Unsupported(t *testing.T) {
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputBrief})
+	require.NoError(t, err)
+	assert.EqualError(t, buf.Render(42), "int cannot be rendered in brief format")
+}
+
+func Test_ExecuteRendersErrorBrief(t *testing.T) {
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputBrief, Target: "bmc17"})
+	require.NoError(t, err)
+	cmd := &cobra.Command{
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errors.New("fail")
+		},
+	}
+	cmd.SetArgs([]string{})
+	exit := Execute(WithRenderer(context.Background(), buf), cmd)
+	assert.Equal(t, EXIT_FAILURE, exit)
+	assert.Equal(t, "bmc17 ERROR error: fail\n", buf.String())
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Example is an invocation of a command shown in its help text.
+// Examples with an Output pattern are also executed by the test suite,
+// which keeps the help text from drifting away from the actual behavior.
+type Example struct {
+	Comment string         // Comment describes what the example does.
+	Args    []string       // Args is the command line, starting with the program name.
+	Output  *regexp.Regexp // Output loosely matches stdout when the example is run.
+}
+
+// Runnable reports whether the example is executed by the test suite.
+func (e Example) Runnable() bool {
+	return e.Output != nil
+}
+
+// String formats the example the way it appears in the help text.
+func (e Example) String() string {
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = shellQuote(arg)
+	}
+	return "  # " + e.Comment + "\n  " + strings.Join(args, " ")
+}
+
+// examplesAnnotation is the cobra.Command annotation holding the examples,
+// so they live exactly as long as the command tree.
+const examplesAnnotation = "bmctl/examples"
+
+// storedExample is the JSON form of an Example in the annotation.
+type storedExample struct {
+	Comment string   `json:"comment"`
+	Args    []string `json:"args"`
+	Output  string   `json:"output,omitempty"`
+}
+
+// SetExamples registers the examples of cmd and generates its Example
+// help text from them.
+func SetExamples(cmd *cobra.Command, ex ...Example) {
+	text := make([]string, len(ex))
+	stored := make([]storedExample, len(ex))
+	for i, e := range ex {
+		text[i] = e.String()
+		stored[i] = storedExample{Comment: e.Comment, Args: e.Args}
+		if e.Runnable() {
+			stored[i].Output = e.Output.String()
+		}
+	}
+	cmd.Example = strings.Join(text, "\n\n")
+	b, err := json.Marshal(stored)
+	if err != nil {
+		panic(err) // cannot happen for strings
+	}
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[examplesAnnotation] = string(b)
+}
+
+// Examples returns the examples registered for cmd.
+func Examples(cmd *cobra.Command) []Example {
+	var stored []storedExample
+	if err := json.Unmarshal([]byte(cmd.Annotations[examplesAnnotation]), &stored); err != nil {
+		return nil
+	}
+	ex := make([]Example, len(stored))
+	for i, s := range stored {
+		ex[i] = Example{Comment: s.Comment, Args: s.Args}
+		if s.Output != "" {
+			// The pattern was compiled by the caller of SetExamples before.
+			ex[i].Output = regexp.MustCompile(s.Output)
+		}
+	}
+	return ex
+}
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// shellQuote quotes s for a POSIX shell if necessary.
+func shellQuote(s string) string {
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SetExamples(t *testing.T) {
+	cmd := &cobra.Command{Use: "version"}
+	ex := []Example{
+		{
+			Comment: "Print the version",
+			Args:    []string{"bmctl", "version"},
+			Output:  regexp.MustCompile(`devel`),
+		},
+		{
+			Comment: "Print only the version string",
+			Args:    []string{"bmctl", "version", "--format", "{{.Version}}"},
+		},
+	}
+	SetExamples(cmd, ex...)
+
+	assert.Equal(t, ex, Examples(cmd))
+	assert.Equal(t, "  # Print the version\n  bmctl version\n\n"+
+		"  # Print only the version string\n  bmctl version --format '{{.Version}}'", cmd.Example)
+	assert.True(t, ex[0].Runnable())
+	assert.False(t, ex[1].Runnable())
+	assert.Empty(t, Examples(&cobra.Command{}))
+}
+
+func Test_shellQuote(t *testing.T) {
+	tests := []struct {
+		arg      string
+		expected string
+	}{
+		{"--output=json", "--output=json"},
+		{"bmc17.example.com:443", "bmc17.example.com:443"},
+		{"{{.PowerState}}", "'{{.PowerState}}'"},
+		{"it's", `'it'\''s'`},
+		{"", "''"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, shellQuote(tt.arg))
+	}
+}
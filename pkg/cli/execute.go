@@ -22,8 +22,9 @@ const (
 // It returns an exit code based on the command execution result:
 //   - EXIT_SUCCESS if the command executes without error
 //   - The code from ErrSilentExit if that error is returned
-//   - EXIT_FAILURE for all other errors, after logging them either with
-//     the zap logger from the context or fmt as a fallback
+//   - EXIT_FAILURE for all other errors, after presenting them with the
+//     renderer from the context if it is an ErrorRenderer, or logging them
+//     with the logger from the context otherwise
 func Execute(ctx context.Context, cmd *cobra.Command) int {
 	cmd.SilenceErrors = true
 	cmd.SilenceUsage = true
@@ -38,7 +39,14 @@ func Execute(ctx context.Context, cmd *cobra.Command) int {
 		return silentExit.Code
 	}
 
-	logger := _logging.FromContext(cmd.Context())
+	// The command context carries the renderer and logger set up by the command.
+	ctx = cmd.Context()
+	if r, ok := RendererFromContext(ctx).(ErrorRenderer); ok {
+		if r.RenderError(err) == nil {
+			return EXIT_FAILURE
+		}
+	}
+	logger := _logging.FromContext(ctx)
 	logger.Error(err.Error())
 
 	return EXIT_FAILURE
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/GSI-HPC/bmctl/pkg/i18n"
+	_logging "github.com/GSI-HPC/bmctl/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Names of the guard flags. Each has exactly one meaning across all commands:
+//   - FlagYes skips confirmation prompts, see Confirm.
+//   - FlagForce overrides safety validations, see Guard.
+//   - FlagIgnoreUnsupported proceeds past capability checks, see Unsupported.
+const (
+	FlagYes               = "yes"
+	FlagForce             = "force"
+	FlagIgnoreUnsupported = "ignore-unsupported"
+)
+
+type guardFlag struct {
+	shorthand string
+	usage     string
+}
+
+var guardFlags = map[string]guardFlag{
+	FlagYes:               {"y", "skip confirmation prompts"},
+	FlagForce:             {"", "override safety validations (each override is logged)"},
+	FlagIgnoreUnsupported: {"", "proceed when the BMC does not advertise a required capability"},
+}
+
+// ErrAborted is returned by Confirm when the user declines.
+var ErrAborted = errors.New("aborted")
+
+// AddGuardFlags adds the named guard flags to cmd.
+// Commands must use this instead of defining the flags themselves.
+func AddGuardFlags(cmd *cobra.Command, names ...string) {
+	for _, name := range names {
+		flag, ok := guardFlags[name]
+		if !ok {
+			panic(fmt.Sprintf("unknown guard flag %q", name))
+		}
+		cmd.Flags().BoolP(name, flag.shorthand, false, flag.usage)
+	}
+}
+
+func guardFlagSet(cmd *cobra.Command, name string) bool {
+	set, err := cmd.Flags().GetBool(name)
+	return err == nil && set
+}
+
+// Confirm asks the user to confirm the described action, unless --yes was
+// given. The prompt is written to stderr and the answer read from stdin.
+// If stdin is not a terminal, Confirm fails instead of waiting for input.
+func Confirm(cmd *cobra.Command, action string) error {
+	if guardFlagSet(cmd, FlagYes) {
+		return nil
+	}
+	in := cmd.InOrStdin()
+	if f, ok := in.(*os.File); ok {
+		info, err := f.Stat()
+		if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+			return errors.New("confirmation required, pass --yes when running non-interactively")
+		}
+	}
+
+	ctx := cmd.Context()
+	if _, err := fmt.Fprint(cmd.ErrOrStderr(), i18n.Sprintf(ctx, i18n.ConfirmPrompt, action)); err != nil {
+		return fmt.Errorf("could not ask for confirmation: %w", err)
+	}
+	answer, err := readLine(in)
+	if err != nil && answer == "" {
+		return ErrAborted
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if slices.Contains(strings.Split(i18n.Sprintf(ctx, i18n.ConfirmYes), ","), answer) {
+		return nil
+	}
+	return ErrAborted
+}
+
+// readLine reads up to and including the next newline one byte at a time,
+// so input after the answer stays available to the command.
+func readLine(in io.Reader) (string, error) {
+	var line []byte
+	var b [1]byte
+	for {
+		n, err := in.Read(b[:])
+		if n > 0 {
+			if b[0] == '\n' {
+				return string(line), nil
+			}
+			line = append(line, b[0])
+		}
+		if err != nil {
+			return string(line), err
+		}
+	}
+}
+
+// Guard enforces the safety validation called name. A nil violation passes.
+// Otherwise the violation is returned, unless --force was given, in which
+// case the override is logged with the guard name and nil is returned.
+func Guard(cmd *cobra.Command, name string, violation error) error {
+	if violation == nil {
+		return nil
+	}
+	if !guardFlagSet(cmd, FlagForce) {
+		return fmt.Errorf("%w (guard %q, use --force to override)", violation, name)
+	}
+	logger := _logging.FromContext(cmd.Context())
+	logger.Warn("overriding safety guard", "guard", name, "reason", violation.Error())
+	return nil
+}
+
+// Unsupported handles a failed capability check. The error is returned,
+// unless --ignore-unsupported was given, in which case it is logged and
+// nil is returned.
+func Unsupported(cmd *cobra.Command, capability string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if !guardFlagSet(cmd, FlagIgnoreUnsupported) {
+		return fmt.Errorf("%w (use --ignore-unsupported to proceed without %s)", err, capability)
+	}
+	logger := _logging.FromContext(cmd.Context())
+	logger.Warn("ignoring unsupported capability", "capability", capability, "reason", err.Error())
+	return nil
+}
+
+// CheckGuardFlags walks the command tree below root and reports every
+// command that defines a guard flag name or shorthand with a meaning other
+// than the one set up by AddGuardFlags.
+func CheckGuardFlags(root *cobra.Command) error {
+	var errs []error
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		check := func(f *pflag.Flag) {
+			if err := checkGuardFlag(f); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", cmd.CommandPath(), err))
+			}
+		}
+		cmd.LocalNonPersistentFlags().VisitAll(check)
+		cmd.PersistentFlags().VisitAll(check)
+		for _, child := range cmd.Commands() {
+			walk(child)
+		}
+	}
+	walk(root)
+	return errors.Join(errs...)
+}
+
+func checkGuardFlag(f *pflag.Flag) error {
+	for name, flag := range guardFlags {
+		if f.Shorthand != "" && f.Shorthand == flag.shorthand && f.Name != name {
+			return fmt.Errorf("flag --%s uses shorthand -%s reserved for --%s", f.Name, f.Shorthand, name)
+		}
+	}
+	flag, ok := guardFlags[f.Name]
+	if !ok {
+		return nil
+	}
+	if f.Value.Type() != "bool" || f.Shorthand != flag.shorthand || f.Usage != flag.usage {
+		return fmt.Errorf("flag --%s diverges from its shared meaning, use cli.AddGuardFlags", f.Name)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/GSI-HPC/bmctl/pkg/i18n"
+	_logging "github.com/GSI-HPC/bmctl/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runGuarded executes a command with all guard flags and the given args,
+// running fn as its body.
+func runGuarded(t *testing.T, ctx context.Context, args []string, stdin string, fn func(cmd *cobra.Command) error) (string, error) {
+	t.Helper()
+	var stderr bytes.Buffer
+	cmd := &cobra.Command{
+		Use:  "reset",
+		RunE: func(cmd *cobra.Command, args []string) error { return fn(cmd) },
+	}
+	AddGuardFlags(cmd, FlagYes, FlagForce, FlagIgnoreUnsupported)
+	cmd.SetArgs(args)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetErr(&stderr)
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	err := cmd.ExecuteContext(ctx)
+	return stderr.String(), err
+}
+
+// logTo returns a context with a logger writing records without time to buf.
+func logTo(buf *bytes.Buffer) context.Context {
+	handler := slog.NewTextHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	return _logging.WithLogger(context.Background(), slog.New(handler))
+}
+
+func Test_Confirm(t *testing.T) {
+	confirm := func(cmd *cobra.Command) error { return Confirm(cmd, "Factory reset bmc17.") }
+	ctx := context.Background()
+
+	stderr, err := runGuarded(t, ctx, nil, "y\n", confirm)
+	assert.NoError(t, err)
+	assert.Equal(t, "Factory reset bmc17.\nContinue? [y/N] ", stderr)
+
+	_, err = runGuarded(t, ctx, nil, "\n", confirm)
+	assert.ErrorIs(t, err, ErrAborted)
+
+	_, err = runGuarded(t, ctx, nil, "", confirm)
+	assert.ErrorIs(t, err, ErrAborted)
+
+	stderr, err = runGuarded(t, ctx, []string{"--yes"}, "", confirm)
+	assert.NoError(t, err)
+	assert.Empty(t, stderr)
+
+	stderr, err = runGuarded(t, i18n.WithLanguage(ctx, "de"), nil, "Ja\n", confirm)
+	assert.NoError(t, err)
+	assert.Contains(t, stderr, "Fortfahren? [j/N] ")
+}
+
+func Test_ConfirmKeepsRemainingInput(t *testing.T) {
+	var rest []byte
+	_, err := runGuarded(t, context.Background(), nil, "y\nsecret\n", func(cmd *cobra.Command) error {
+		if err := Confirm(cmd, "Set the LDAP bind password."); err != nil {
+			return err
+		}
+		var err error
+		rest, err = io.ReadAll(cmd.InOrStdin())
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "secret\n", string(rest))
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("broken pipe") }
+
+func Test_ConfirmPromptNotWritten(t *testing.T) {
+	cmd := &cobra.Command{}
+	AddGuardFlags(cmd, FlagYes)
+	cmd.SetIn(strings.NewReader("y\n"))
+	cmd.SetErr(failingWriter{})
+	cmd.SetContext(context.Background())
+	err := Confirm(cmd, "Factory reset bmc17.")
+	assert.EqualError(t, err, "could not ask for confirmation: broken pipe")
+	assert.NotErrorIs(t, err, ErrAborted)
+}
+
+func Test_ConfirmNonInteractive(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "stdin")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	cmd := &cobra.Command{}
+	AddGuardFlags(cmd, FlagYes)
+	cmd.SetIn(f)
+	err = Confirm(cmd, "Delete all subscriptions.")
+	assert.EqualError(t, err, "confirmation required, pass --yes when running non-interactively")
+}
+
+func Test_Guard(t *testing.T) {
+	violation := errors.New("chassis reports thermal trip on CPU2")
+	guard := func(cmd *cobra.Command) error { return Guard(cmd, "thermal-trip", violation) }
+	var log bytes.Buffer
+	ctx := logTo(&log)
+
+	_, err := runGuarded(t, ctx, nil, "", guard)
+	assert.ErrorIs(t, err, violation)
+	assert.EqualError(t, err, `chassis reports thermal trip on CPU2 (guard "thermal-trip", use --force to override)`)
+	assert.Empty(t, log.String())
+
+	_, err = runGuarded(t, ctx, []string{"--force"}, "", guard)
+	assert.NoError(t, err)
+	assert.Equal(t, `level=WARN msg="overriding safety guard" guard=thermal-trip reason="chassis reports thermal trip on CPU2"`+"\n", log.String())
+
+	_, err = runGuarded(t, ctx, nil, "", func(cmd *cobra.Command) error { return Guard(cmd, "thermal-trip", nil) })
+	assert.NoError(t, err)
+}
+
+func Test_Unsupported(t *testing.T) {
+	missing := errors.New("no ResetToDefaults action")
+	unsupported := func(cmd *cobra.Command) error { return Unsupported(cmd, "factory reset", missing) }
+	var log bytes.Buffer
+	ctx := logTo(&log)
+
+	_, err := runGuarded(t, ctx, nil, "", unsupported)
+	assert.EqualError(t, err, "no ResetToDefaults action (use --ignore-unsupported to proceed without factory reset)")
+	assert.Empty(t, log.String())
+
+	_, err = runGuarded(t, ctx, []string{"--ignore-unsupported"}, "", unsupported)
+	assert.NoError(t, err)
+	assert.Equal(t, `level=WARN msg="ignoring unsupported capability" capability="factory reset" reason="no ResetToDefaults action"`+"\n", log.String())
+}
+
+func Test_CheckGuardFlags(t *testing.T) {
+	root := &cobra.Command{Use: "bmctl"}
+	good := &cobra.Command{Use: "good", Run: func(*cobra.Command, []string) {}}
+	AddGuardFlags(good, FlagYes, FlagForce)
+	root.AddCommand(good)
+	require.NoError(t, CheckGuardFlags(root))
+
+	divergent := &cobra.Command{Use: "divergent", Run: func(*cobra.Command, []string) {}}
+	divergent.Flags().BoolP("force", "f", false, "skip confirmation")
+	root.AddCommand(divergent)
+	shorthand := &cobra.Command{Use: "shorthand", Run: func(*cobra.Command, []string) {}}
+	shorthand.Flags().BoolP("yolo", "y", false, "do it")
+	root.AddCommand(shorthand)
+
+	err := CheckGuardFlags(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bmctl divergent: flag --force diverges from its shared meaning")
+	assert.Contains(t, err.Error(), "bmctl shorthand: flag --yolo uses shorthand -y reserved for --yes")
+}
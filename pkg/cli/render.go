@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Output formats accepted by NewRenderer.
+const (
+	OutputText  = "text"
+	OutputJSON  = "json"
+	OutputTable = "table"
+	OutputBrief = "brief"
+)
+
+// Renderer presents the typed result of a command.
+// Commands hand their result structs to a Renderer instead of writing to
+// stdout directly, which keeps presentation separate from process I/O.
+type Renderer interface {
+	Render(v any) error
+}
+
+// Table is implemented by results that can be rendered with OutputTable.
+type Table interface {
+	Columns() []Column // Columns describes the columns of the table.
+	Rows() [][]any     // Rows returns the cells, one slice per row.
+}
+
+// RenderOptions configures the Renderer returned by NewRenderer.
+type RenderOptions struct {
+	Format     string // Format is one of OutputText, OutputJSON, OutputTable or OutputBrief.
+	Template   string // Template is a text/template overriding Format if set.
+	Wide       bool   // Wide shows all table columns, including Column.Wide ones.
+	Width      int    // Width limits the table width, 0 means unlimited.
+	Target     string // Target names the BMC at the start of brief lines, unused until endpoints exist.
+	TimeFormat string // TimeFormat is TimeLocal (default), TimeUTC or TimeRelative.
+}
+
+// NewRenderer returns a Renderer writing to w in the format selected by opts.
+// An empty format selects OutputText.
+func NewRenderer(w io.Writer, opts RenderOptions) (Renderer, error) {
+	times, err := newTimeFormatter(opts.TimeFormat)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Template != "" {
+		return newTemplateRenderer(w, opts.Template)
+	}
+	switch opts.Format {
+	case "", OutputText:
+		return &textRenderer{w: w}, nil
+	case OutputJSON:
+		return &jsonRenderer{w: w}, nil
+	case OutputTable:
+		return &tableRenderer{w: w, wide: opts.Wide, width: opts.Width, times: times}, nil
+	case OutputBrief:
+		return &briefRenderer{w: w, target: opts.Target, times: times}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", opts.Format)
+}
+
+// textRenderer prints a result using its String method if it has one,
+// and the default fmt formatting otherwise.
+type textRenderer struct {
+	w io.Writer
+}
+
+func (r *textRenderer) Render(v any) error {
+	if s, ok := v.(fmt.Stringer); ok {
+		_, err := fmt.Fprintln(r.w, s.String())
+		return err
+	}
+	_, err := fmt.Fprintln(r.w, v)
+	return err
+}
+
+// jsonRenderer prints a result as indented JSON.
+type jsonRenderer struct {
+	w io.Writer
+}
+
+func (r *jsonRenderer) Render(v any) error {
+	enc := json.NewEncoder(r.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// BufferRenderer is a Renderer writing into an in-memory buffer.
+// It is meant for tests and for capturing the output of commands invoked
+// as a library.
+type BufferRenderer struct {
+	Renderer
+	bytes.Buffer
+}
+
+// NewBufferRenderer returns a BufferRenderer in the format selected by opts.
+func NewBufferRenderer(opts RenderOptions) (*BufferRenderer, error) {
+	b := &BufferRenderer{}
+	r, err := NewRenderer(&b.Buffer, opts)
+	if err != nil {
+		return nil, err
+	}
+	b.Renderer = r
+	return b, nil
+}
+
+// RenderError passes err on if the underlying renderer is an ErrorRenderer
+// and fails with errors.ErrUnsupported otherwise.
+func (b *BufferRenderer) RenderError(err error) error {
+	if r, ok := b.Renderer.(ErrorRenderer); ok {
+		return r.RenderError(err)
+	}
+	return errors.ErrUnsupported
+}
+
+type rendererKey struct{}
+
+// WithRenderer adds a renderer to the context
+func WithRenderer(ctx context.Context, r Renderer) context.Context {
+	return context.WithValue(ctx, rendererKey{}, r)
+}
+
+// HasRenderer reports whether a renderer was added to the context
+func HasRenderer(ctx context.Context) bool {
+	_, ok := ctx.Value(rendererKey{}).(Renderer)
+	return ok
+}
+
+// RendererFromContext retrieves the renderer from context
+// It never returns nil, falling back to a text renderer on stdout.
+func RendererFromContext(ctx context.Context) Renderer {
+	if r, ok := ctx.Value(rendererKey{}).(Renderer); ok {
+		return r
+	}
+	return &textRenderer{w: os.Stdout}
+}
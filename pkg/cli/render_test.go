@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	_testing "github.com/GSI-HPC/bmctl/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testResult struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+func (r testResult) String() string {
+	return r.Name + " is " + r.State
+}
+
+func (r testResult) Columns() []Column {
+	return []Column{{Header: "NAME", Key: true}, {Header: "STATE"}}
+}
+
+func (r testResult) Rows() [][]any {
+	return [][]any{{r.Name, r.State}}
+}
+
+func renderTo(t *testing.T, format string, v any) string {
+	t.Helper()
+	buf, err := NewBufferRenderer(RenderOptions{Format: format})
+	require.NoError(t, err)
+	require.NoError(t, buf.Render(v))
+	return buf.String()
+}
+
+func Test_NewRenderer_Text(t *testing.T) {
+	assert.Equal(t, "node01 is On\n", renderTo(t, OutputText, testResult{"node01", "On"}))
+	assert.Equal(t, "node01 is On\n", renderTo(t, "", testResult{"node01", "On"}))
+	assert.Equal(t, "42\n", renderTo(t, OutputText, 42))
+}
+
+func Test_NewRenderer_JSON(t *testing.T) {
+	expected := "{\n  \"name\": \"node01\",\n  \"state\": \"On\"\n}\n"
+	assert.Equal(t, expected, renderTo(t, OutputJSON, testResult{"node01", "On"}))
+}
+
+func Test_NewRenderer_Table(t *testing.T) {
+	expected := "NAME    STATE\nnode01  On\n"
+	assert.Equal(t, expected, renderTo(t, OutputTable, testResult{"node01", "On"}))
+}
+
+func Test_NewRenderer_TableUnsupported(t *testing.T) {
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputTable})
+	require.NoError(t, err)
+	err = buf.Render(42)
+	assert.EqualError(t, err, "int cannot be rendered as a table")
+}
+
+func Test_NewRenderer_UnknownFormat(t *testing.T) {
+	_, err := NewRenderer(os.Stdout, RenderOptions{Format: "xml"})
+	assert.EqualError(t, err, `unknown output format "xml"`)
+}
+
+func Test_WithRendererAndRendererFromContext(t *testing.T) {
+	ctx := context.Background()
+	buf, err := NewBufferRenderer(RenderOptions{})
+	require.NoError(t, err)
+	ctxWithRenderer := WithRenderer(ctx, buf)
+	assert.True(t, HasRenderer(ctxWithRenderer))
+	assert.Same(t, buf, RendererFromContext(ctxWithRenderer))
+}
+
+func Test_RendererFromContextDefaultsToStdout(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, HasRenderer(ctx))
+	getStdout := _testing.Capture(os.Stdout)
+	err := RendererFromContext(ctx).Render(testResult{"node01", "Off"})
+	require.NoError(t, err)
+	assert.Equal(t, "node01 is Off\n", getStdout())
+}
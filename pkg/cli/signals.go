@@ -6,38 +6,114 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"golang.org/x/sys/unix"
 )
 
 var terminationSignals = []os.Signal{unix.SIGTERM, unix.SIGINT}
 
+// ErrInterrupted is the cancellation cause of the context returned by
+// SignalContext, wrapped with the name of the received signal.
+var ErrInterrupted = errors.New("interrupted")
+
+// forceExitWindow is how soon a repeated signal has to follow the previous
+// one to force the exit.
+var forceExitWindow = 3 * time.Second
+
 // SignalContext returns a context that is canceled when a termination signal is received.
-// It listens for SIGTERM and SIGINT. On the first signal, the context is canceled with
-// an error describing the signal. If a second signal is received, the process exits immediately.
-// This allows for graceful shutdown on the first signal and forced termination on the second.
+// It listens for SIGTERM and SIGINT and escalates in two steps:
+//   - The first signal cancels the context with a cause wrapping ErrInterrupted
+//     and tells the user on stderr how soon another interrupt forces the exit.
+//     Commands are expected to wind down gracefully, e.g. eject virtual
+//     media or log out of the BMC session.
+//   - A second signal within forceExitWindow of the previous one runs the
+//     hooks registered with OnForcedExit and exits the process immediately
+//     with EXIT_FAILURE. A later signal only repeats the notice, so a
+//     stray interrupt during a long teardown does not skip it.
 func SignalContext() context.Context {
-	const limit = 2
-	signals := make(chan os.Signal, limit)
+	signals := make(chan os.Signal, 2)
 	signal.Notify(signals, terminationSignals...)
 
 	ctx, cancel := context.WithCancelCause(context.Background())
 
 	go func() {
-		retries := 0
-		for range signals {
-			retries++
-			err := fmt.Errorf("got %d interrupts, forcing shutdown", retries)
-			cancel(err)
-			if retries >= limit {
-				fmt.Fprintln(os.Stderr, err)
+		var last time.Time
+		for sig := range signals {
+			if !last.IsZero() && time.Since(last) < forceExitWindow {
+				fmt.Fprintf(os.Stderr, "interrupted again within %s, forcing shutdown\n", forceExitWindow)
+				runForcedExitHooks()
 				os.Exit(EXIT_FAILURE)
 			}
+			last = time.Now()
+			cancel(fmt.Errorf("%w: received %s", ErrInterrupted, signalName(sig)))
+			fmt.Fprintf(os.Stderr, "shutting down gracefully, interrupt again within %s to force\n", forceExitWindow)
 		}
 	}()
 
 	return ctx
 }
+
+// forcedExitTimeout bounds how long the hooks may delay a forced exit.
+var forcedExitTimeout = time.Second
+
+var (
+	forcedExitMu    sync.Mutex
+	forcedExitNext  int
+	forcedExitHooks = map[int]func(){}
+)
+
+// OnForcedExit registers f to run when a repeated signal forces the exit,
+// which skips deferred calls. It is meant for cleanup that must happen
+// even then, e.g. removing temporary files. The returned function
+// unregisters f again and should be deferred next to the regular cleanup.
+//
+// Hooks must be fast, the process exits after forcedExitTimeout even if
+// they have not finished. They must not call OnForcedExit or an unregister
+// function, which would block on the lock held while they run.
+func OnForcedExit(f func()) (unregister func()) {
+	forcedExitMu.Lock()
+	defer forcedExitMu.Unlock()
+	id := forcedExitNext
+	forcedExitNext++
+	forcedExitHooks[id] = f
+	return func() {
+		forcedExitMu.Lock()
+		defer forcedExitMu.Unlock()
+		delete(forcedExitHooks, id)
+	}
+}
+
+// runForcedExitHooks runs the registered hooks for at most
+// forcedExitTimeout. It keeps the lock, so no hook is unregistered and its
+// resource released while exiting.
+func runForcedExitHooks() {
+	done := make(chan struct{})
+	go func() {
+		forcedExitMu.Lock()
+		for _, f := range forcedExitHooks {
+			f()
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(forcedExitTimeout):
+		fmt.Fprintf(os.Stderr, "cleanup did not finish within %s, exiting anyway\n", forcedExitTimeout)
+	}
+}
+
+func signalName(sig os.Signal) string {
+	if s, ok := sig.(syscall.Signal); ok {
+		if name := unix.SignalName(s); name != "" {
+			return name
+		}
+	}
+	return sig.String()
+}
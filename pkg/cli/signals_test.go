@@ -5,8 +5,11 @@
 package cli
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -25,7 +28,9 @@ func Test_SignalContext_CancelOnSignal(t *testing.T) {
 
 	select {
 	case <-ctx.Done():
-		// Success: context cancelled
+		err := context.Cause(ctx)
+		assert.ErrorIs(t, err, ErrInterrupted)
+		assert.EqualError(t, err, "interrupted: received SIGINT")
 	case <-time.After(1 * time.Second):
 		assert.Fail(t, "context was not cancelled after signal")
 	}
@@ -34,6 +39,9 @@ func Test_SignalContext_CancelOnSignal(t *testing.T) {
 func Test_SignalContext_ForceShutdown(t *testing.T) {
 	if os.Getenv("FORK") == "1" {
 		ctx := SignalContext()
+		OnForcedExit(func() { fmt.Println("cleaned up") })
+		unregister := OnForcedExit(func() { fmt.Println("unregistered") })
+		unregister()
 		p, err := os.FindProcess(os.Getpid())
 		require.NoError(t, err)
 		err = p.Signal(syscall.SIGINT)
@@ -49,6 +57,51 @@ func Test_SignalContext_ForceShutdown(t *testing.T) {
 	exiterr, ok := err.(*exec.ExitError)
 	assert.True(t, ok)
 	assert.Equal(t, exiterr.ExitCode(), EXIT_FAILURE)
-	assert.Contains(t, stderr, "got 2 interrupts, forcing shutdown")
-	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "shutting down gracefully, interrupt again within 3s to force\n")
+	assert.Contains(t, stderr, "interrupted again within 3s, forcing shutdown")
+	assert.Equal(t, "cleaned up\n", stdout)
+}
+
+func Test_SignalContext_SecondSignalOutsideWindow(t *testing.T) {
+	if os.Getenv("FORK") == "1" {
+		forceExitWindow = 50 * time.Millisecond
+		ctx := SignalContext()
+		p, err := os.FindProcess(os.Getpid())
+		require.NoError(t, err)
+		require.NoError(t, p.Signal(syscall.SIGINT))
+		<-ctx.Done()
+		time.Sleep(200 * time.Millisecond)
+		require.NoError(t, p.Signal(syscall.SIGINT))
+		time.Sleep(100 * time.Millisecond)
+		assert.EqualError(t, context.Cause(ctx), "interrupted: received SIGINT")
+		return
+	}
+
+	_, stderr, err := _testing.RunForkTest("Test_SignalContext_SecondSignalOutsideWindow")
+	require.NoError(t, err, stderr)
+	assert.Equal(t, 2, strings.Count(stderr, "shutting down gracefully, interrupt again within 50ms to force\n"))
+	assert.NotContains(t, stderr, "forcing shutdown")
+}
+
+func Test_SignalContext_ForceShutdownWithBlockingHook(t *testing.T) {
+	if os.Getenv("FORK") == "1" {
+		forcedExitTimeout = 100 * time.Millisecond
+		ctx := SignalContext()
+		OnForcedExit(func() { select {} })
+		p, err := os.FindProcess(os.Getpid())
+		require.NoError(t, err)
+		require.NoError(t, p.Signal(syscall.SIGINT))
+		<-ctx.Done()
+		require.NoError(t, p.Signal(syscall.SIGINT))
+		time.Sleep(10 * time.Second)
+		return
+	}
+
+	start := time.Now()
+	_, stderr, err := _testing.RunForkTest("Test_SignalContext_ForceShutdownWithBlockingHook")
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, EXIT_FAILURE, exitErr.ExitCode())
+	assert.Contains(t, stderr, "cleanup did not finish within 100ms, exiting anyway\n")
+	assert.Less(t, time.Since(start), 5*time.Second)
 }
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	columnGap      = 2   // columnGap is the number of spaces between columns.
+	minColumnWidth = 6   // minColumnWidth is the narrowest a column is truncated to.
+	ellipsis       = "…" // ellipsis marks truncated cell values.
+)
+
+// Column describes a column of a Table.
+type Column struct {
+	Header   string // Header is printed in the first line of the table.
+	Key      bool   // Key columns identify a row and are never dropped or truncated.
+	Wide     bool   // Wide columns are only shown with RenderOptions.Wide.
+	Priority int    // Priority orders dropping on narrow terminals, lowest first.
+}
+
+// TerminalWidth returns the number of columns of the terminal f is
+// connected to, or 0 if f is not a terminal.
+func TerminalWidth(f *os.File) int {
+	ws, err := unix.IoctlGetWinsize(int(f.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0
+	}
+	return int(ws.Col)
+}
+
+// tableRenderer prints results implementing Table as aligned columns.
+// With a width limit, columns are dropped by priority and cells truncated
+// until the table fits. Without one (i.e. output is not a terminal) the
+// values are printed in full.
+type tableRenderer struct {
+	w     io.Writer
+	wide  bool
+	width int
+	times timeFormatter
+}
+
+func (r *tableRenderer) Render(v any) error {
+	t, ok := v.(Table)
+	if !ok {
+		return fmt.Errorf("%T cannot be rendered as a table", v)
+	}
+	cols := t.Columns()
+	cells := [][]string{make([]string, len(cols))}
+	for i, col := range cols {
+		cells[0][i] = col.Header
+	}
+	for _, row := range t.Rows() {
+		line := make([]string, len(cols))
+		for i := range cols {
+			if i < len(row) {
+				line[i] = r.times.format(row[i])
+			}
+		}
+		cells = append(cells, line)
+	}
+
+	visible, widths := layoutTable(cols, cells, r.wide, r.width)
+	var b strings.Builder
+	for _, line := range cells {
+		for i, c := range visible {
+			cell := truncate(line[c], widths[i])
+			b.WriteString(cell)
+			if i < len(visible)-1 {
+				pad := widths[i] - utf8.RuneCountInString(cell) + columnGap
+				b.WriteString(strings.Repeat(" ", pad))
+			}
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(r.w, b.String())
+	return err
+}
+
+// layoutTable selects the visible columns and their widths for a table
+// whose first line of cells is the header. A width of 0 means unlimited.
+//
+// Columns that do not fit even when truncated to minColumnWidth are dropped,
+// lowest priority first and rightmost on ties. Once the remaining columns
+// fit truncated, the widest non-key columns are shrunk until the table
+// fits. Key columns are always kept intact, even if that overflows width.
+func layoutTable(cols []Column, cells [][]string, wide bool, width int) ([]int, []int) {
+	natural := make([]int, len(cols))
+	for _, line := range cells {
+		for i, cell := range line {
+			natural[i] = max(natural[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	var visible []int
+	for i, col := range cols {
+		if wide || !col.Wide {
+			visible = append(visible, i)
+		}
+	}
+
+	for width > 0 {
+		total, minimum := columnGap*(len(visible)-1), columnGap*(len(visible)-1)
+		for _, c := range visible {
+			total += natural[c]
+			if cols[c].Key {
+				minimum += natural[c]
+			} else {
+				minimum += min(natural[c], minColumnWidth)
+			}
+		}
+		if total <= width {
+			break
+		}
+		if minimum <= width {
+			return visible, shrinkColumns(cols, visible, natural, total-width)
+		}
+		drop := -1
+		for i, c := range visible {
+			if !cols[c].Key && (drop < 0 || cols[c].Priority <= cols[visible[drop]].Priority) {
+				drop = i
+			}
+		}
+		if drop < 0 {
+			break
+		}
+		visible = append(visible[:drop], visible[drop+1:]...)
+	}
+
+	widths := make([]int, len(visible))
+	for i, c := range visible {
+		widths[i] = natural[c]
+	}
+	return visible, widths
+}
+
+// shrinkColumns reduces the widest non-key columns by excess characters in
+// total, never below minColumnWidth.
+func shrinkColumns(cols []Column, visible []int, natural []int, excess int) []int {
+	widths := make([]int, len(visible))
+	for i, c := range visible {
+		widths[i] = natural[c]
+	}
+	for ; excess > 0; excess-- {
+		widest := -1
+		for i, c := range visible {
+			if !cols[c].Key && widths[i] > minColumnWidth && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+	}
+	return widths
+}
+
+// truncate shortens s to width characters, marking the cut with an ellipsis.
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:width-1]) + ellipsis
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testNodes struct{}
+
+func (testNodes) Columns() []Column {
+	return []Column{
+		{Header: "NAME", Key: true},
+		{Header: "POWER", Priority: 3},
+		{Header: "HEALTH", Priority: 2},
+		{Header: "FIRMWARE", Priority: 1},
+		{Header: "MODEL", Wide: true},
+	}
+}
+
+func (testNodes) Rows() [][]any {
+	return [][]any{
+		{"node0417.example.com", "On", "OK", "iLO 5 v2.81 (Jun 12 2023)", "ProLiant DL360 Gen10 Plus"},
+		{"node0418.example.com", "Off", "Critical", "iLO 5 v2.72", "ProLiant DL360 Gen10"},
+	}
+}
+
+func renderTable(t *testing.T, wide bool, width int) string {
+	t.Helper()
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputTable, Wide: wide, Width: width})
+	require.NoError(t, err)
+	require.NoError(t, buf.Render(testNodes{}))
+	return buf.String()
+}
+
+func Test_TableRenderer_Layout(t *testing.T) {
+	tests := []struct {
+		wide     bool
+		width    int
+		expected []string
+	}{
+		{false, 0, []string{
+			"NAME                  POWER  HEALTH    FIRMWARE",
+			"node0417.example.com  On     OK        iLO 5 v2.81 (Jun 12 2023)",
+			"node0418.example.com  Off    Critical  iLO 5 v2.72",
+		}},
+		{true, 0, []string{
+			"NAME                  POWER  HEALTH    FIRMWARE                   MODEL",
+			"node0417.example.com  On     OK        iLO 5 v2.81 (Jun 12 2023)  ProLiant DL360 Gen10 Plus",
+			"node0418.example.com  Off    Critical  iLO 5 v2.72                ProLiant DL360 Gen10",
+		}},
+		{false, 80, []string{
+			"NAME                  POWER  HEALTH    FIRMWARE",
+			"node0417.example.com  On     OK        iLO 5 v2.81 (Jun 12 2023)",
+			"node0418.example.com  Off    Critical  iLO 5 v2.72",
+		}},
+		{true, 80, []string{
+			"NAME                  POWER  HEALTH    FIRMWARE             MODEL",
+			"node0417.example.com  On     OK        iLO 5 v2.81 (Jun 1…  ProLiant DL360 Gen1…",
+			"node0418.example.com  Off    Critical  iLO 5 v2.72          ProLiant DL360 Gen10",
+		}},
+		{false, 50, []string{
+			"NAME                  POWER  HEALTH    FIRMWARE",
+			"node0417.example.com  On     OK        iLO 5 v2.8…",
+			"node0418.example.com  Off    Critical  iLO 5 v2.72",
+		}},
+		{false, 40, []string{
+			"NAME                  POWER  HEALTH",
+			"node0417.example.com  On     OK",
+			"node0418.example.com  Off    Critical",
+		}},
+		{false, 30, []string{
+			"NAME                  POWER",
+			"node0417.example.com  On",
+			"node0418.example.com  Off",
+		}},
+		{false, 10, []string{
+			"NAME",
+			"node0417.example.com",
+			"node0418.example.com",
+		}},
+	}
+
+	for _, tt := range tests {
+		output := renderTable(t, tt.wide, tt.width)
+		assert.Equal(t, strings.Join(tt.expected, "\n")+"\n", output, "wide=%v width=%d", tt.wide, tt.width)
+		if tt.width >= 20 {
+			for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+				assert.LessOrEqual(t, len([]rune(line)), tt.width)
+			}
+		}
+	}
+}
+
+func Test_TableRenderer_ShortRows(t *testing.T) {
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputTable})
+	require.NoError(t, err)
+	require.NoError(t, buf.Render(testResult{Name: "node01"}))
+	assert.Equal(t, "NAME    STATE\nnode01  \n", buf.String())
+}
+
+func Test_Truncate(t *testing.T) {
+	assert.Equal(t, "PowerEdge", truncate("PowerEdge", 9))
+	assert.Equal(t, "Power…", truncate("PowerEdge", 6))
+	assert.Equal(t, "Tempé…", truncate("Température", 6))
+}
+
+func Test_TerminalWidthNotATerminal(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	assert.Equal(t, 0, TerminalWidth(f))
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are available in addition to the text/template builtins.
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join": func(elems []string, sep string) string {
+		return strings.Join(elems, sep)
+	},
+}
+
+// templateRenderer executes a user provided text/template on each result.
+// The template sees the same structs that are encoded by OutputJSON.
+type templateRenderer struct {
+	w    io.Writer
+	text string
+	tmpl *template.Template
+}
+
+func newTemplateRenderer(w io.Writer, text string) (*templateRenderer, error) {
+	tmpl, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format template %q: %w", text, err)
+	}
+	return &templateRenderer{w: w, text: text, tmpl: tmpl}, nil
+}
+
+func (r *templateRenderer) Render(v any) error {
+	var b strings.Builder
+	if err := r.tmpl.Execute(&b, v); err != nil {
+		return fmt.Errorf("could not execute format template %q: %w", r.text, err)
+	}
+	_, err := fmt.Fprintln(r.w, b.String())
+	return err
+}
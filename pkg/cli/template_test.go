@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func renderTemplate(t *testing.T, text string, v any) (string, error) {
+	t.Helper()
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputJSON, Template: text})
+	if err != nil {
+		return "", err
+	}
+	err = buf.Render(v)
+	return buf.String(), err
+}
+
+func Test_TemplateRenderer(t *testing.T) {
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{`{{.State}}`, "On\n"},
+		{`{{.Name}}: {{.State | upper}}`, "node01: ON\n"},
+		{`{{lower .State}}`, "on\n"},
+		{`{{json .}}`, "{\"name\":\"node01\",\"state\":\"On\"}\n"},
+	}
+
+	for _, tt := range tests {
+		output, err := renderTemplate(t, tt.template, testResult{"node01", "On"})
+		require.NoError(t, err, tt.template)
+		assert.Equal(t, tt.expected, output, tt.template)
+	}
+}
+
+func Test_TemplateRenderer_Join(t *testing.T) {
+	v := struct{ Servers []string }{[]string{"ntp1", "ntp2"}}
+	output, err := renderTemplate(t, `{{join .Servers ","}}`, v)
+	require.NoError(t, err)
+	assert.Equal(t, "ntp1,ntp2\n", output)
+}
+
+func Test_TemplateRenderer_ParseError(t *testing.T) {
+	_, err := renderTemplate(t, `{{.State`, testResult{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid format template "{{.State"`)
+}
+
+func Test_TemplateRenderer_ExecError(t *testing.T) {
+	_, err := renderTemplate(t, `{{.PowerState}}`, testResult{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `could not execute format template "{{.PowerState}}"`)
+	assert.Contains(t, err.Error(), "<.PowerState>")
+}
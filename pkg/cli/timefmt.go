@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Time formats for human readable output, selected with
+// RenderOptions.TimeFormat. JSON output is not affected.
+const (
+	TimeLocal    = "local"    // TimeLocal prints timestamps in the local time zone.
+	TimeUTC      = "utc"      // TimeUTC prints timestamps in UTC.
+	TimeRelative = "relative" // TimeRelative prints timestamps like "3m ago".
+)
+
+// humanTimeLayout is the one layout used for absolute timestamps.
+const humanTimeLayout = "2006-01-02 15:04:05 MST"
+
+// now is replaced in tests to get stable relative timestamps.
+var now = time.Now
+
+// Timestamp is a point in time in a command result.
+// It is encoded as RFC 3339 in UTC in JSON, or null if zero, and printed
+// according to the selected time format in tables and brief output.
+type Timestamp time.Time
+
+// BMCTimestamp converts a timestamp reported by a BMC to local clock time,
+// given the offset of the BMC clock (BMC time minus local time) if it was
+// measured in the same invocation. Pass 0 if the offset is unknown.
+func BMCTimestamp(t time.Time, offset time.Duration) Timestamp {
+	return Timestamp(t.Add(-offset))
+}
+
+// String returns the timestamp as RFC 3339 in UTC.
+func (t Timestamp) String() string {
+	if time.Time(t).IsZero() {
+		return ""
+	}
+	return time.Time(t).UTC().Format(time.RFC3339)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if time.Time(t).IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// Duration is a time span in a command result.
+// It is encoded as integer milliseconds in JSON and printed in a short
+// human form like "1m30s" or "250ms" elsewhere.
+type Duration time.Duration
+
+// String returns the duration rounded to seconds, or to milliseconds if
+// it is shorter than a second.
+func (d Duration) String() string {
+	td := time.Duration(d)
+	if td > -time.Second && td < time.Second {
+		return td.Round(time.Millisecond).String()
+	}
+	return td.Round(time.Second).String()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(time.Duration(d).Milliseconds(), 10)), nil
+}
+
+// timeFormatter formats table cells and brief fields, printing timestamps
+// and durations in the selected time format.
+type timeFormatter struct {
+	mode string
+}
+
+func newTimeFormatter(mode string) (timeFormatter, error) {
+	switch mode {
+	case "":
+		return timeFormatter{mode: TimeLocal}, nil
+	case TimeLocal, TimeUTC, TimeRelative:
+		return timeFormatter{mode: mode}, nil
+	}
+	return timeFormatter{}, fmt.Errorf("unknown time format %q", mode)
+}
+
+func (f timeFormatter) format(v any) string {
+	// Optional values are pointers, print nil like a zero Timestamp.
+	switch p := v.(type) {
+	case *Timestamp:
+		if p == nil {
+			return ""
+		}
+		v = *p
+	case *time.Time:
+		if p == nil {
+			return ""
+		}
+		v = *p
+	case *Duration:
+		if p == nil {
+			return ""
+		}
+		v = *p
+	case *time.Duration:
+		if p == nil {
+			return ""
+		}
+		v = *p
+	}
+	switch v := v.(type) {
+	case Timestamp:
+		return f.formatTime(time.Time(v))
+	case time.Time:
+		return f.formatTime(v)
+	case time.Duration:
+		return Duration(v).String()
+	}
+	return fmt.Sprint(v)
+}
+
+func (f timeFormatter) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	switch f.mode {
+	case TimeUTC:
+		return t.UTC().Format(humanTimeLayout)
+	case TimeRelative:
+		return relativeTime(now().Sub(t))
+	}
+	return t.Local().Format(humanTimeLayout)
+}
+
+// relativeTime formats the age of a timestamp like "3m ago" or "in 5s".
+func relativeTime(age time.Duration) string {
+	future := age < 0
+	if future {
+		age = -age
+	}
+	var s string
+	switch {
+	case age < time.Second:
+		return "just now"
+	case age < time.Minute:
+		s = fmt.Sprintf("%ds", int(age/time.Second))
+	case age < time.Hour:
+		s = fmt.Sprintf("%dm", int(age/time.Minute))
+	case age < 48*time.Hour:
+		s = fmt.Sprintf("%dh", int(age/time.Hour))
+	default:
+		s = fmt.Sprintf("%dd", int(age/(24*time.Hour)))
+	}
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+
+type testTask struct {
+	Name     string    `json:"name"`
+	Started  Timestamp `json:"started"`
+	Finished Timestamp `json:"finished"`
+	Took     Duration  `json:"took"`
+}
+
+func (t testTask) Columns() []Column {
+	return []Column{{Header: "NAME", Key: true}, {Header: "STARTED"}, {Header: "FINISHED"}, {Header: "TOOK"}}
+}
+
+func (t testTask) Rows() [][]any {
+	return [][]any{{t.Name, t.Started, t.Finished, t.Took}}
+}
+
+func (t testTask) BriefFields() []Field {
+	return []Field{{"started", t.Started}, {"took", t.Took}}
+}
+
+var testTaskResult = testTask{
+	Name:    "firmware-update",
+	Started: Timestamp(testNow.Add(-3*time.Minute - 20*time.Second)),
+	Took:    Duration(90*time.Second + 400*time.Millisecond),
+}
+
+// withTestClock fixes the current time and the local time zone.
+func withTestClock(t *testing.T) {
+	t.Helper()
+	oldNow, oldLocal := now, time.Local
+	now = func() time.Time { return testNow }
+	time.Local = time.FixedZone("CET", 3600)
+	t.Cleanup(func() { now, time.Local = oldNow, oldLocal })
+}
+
+func Test_TimeFormats(t *testing.T) {
+	withTestClock(t)
+	for _, mode := range []string{TimeLocal, TimeUTC, TimeRelative} {
+		t.Run(mode, func(t *testing.T) {
+			var output string
+			for _, format := range []string{OutputTable, OutputBrief} {
+				buf, err := NewBufferRenderer(RenderOptions{Format: format, TimeFormat: mode})
+				require.NoError(t, err)
+				require.NoError(t, buf.Render(testTaskResult))
+				output += buf.String()
+			}
+			assertGolden(t, "time/"+mode+".golden", output)
+		})
+	}
+}
+
+type testOptionalTask struct {
+	Started  *Timestamp
+	Finished *time.Time
+	Took     *Duration
+}
+
+func (t testOptionalTask) Columns() []Column {
+	return []Column{{Header: "STARTED"}, {Header: "FINISHED"}, {Header: "TOOK"}}
+}
+
+func (t testOptionalTask) Rows() [][]any {
+	return [][]any{{t.Started, t.Finished, t.Took}}
+}
+
+func Test_TimeFormatPointers(t *testing.T) {
+	started := Timestamp(testNow.Add(-time.Hour))
+	finished := testNow.Add(-time.Minute)
+	took := Duration(59 * time.Minute)
+	buf, err := NewBufferRenderer(RenderOptions{Format: OutputTable, TimeFormat: TimeUTC})
+	require.NoError(t, err)
+	require.NoError(t, buf.Render(testOptionalTask{&started, &finished, &took}))
+	require.NoError(t, buf.Render(testOptionalTask{}))
+	expected := "STARTED                  FINISHED                 TOOK\n" +
+		"2025-03-14 11:00:00 UTC  2025-03-14 11:59:00 UTC  59m0s\n" +
+		"STARTED  FINISHED  TOOK\n" +
+		"                   \n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_TimeFormatUnknown(t *testing.T) {
+	for _, opts := range []RenderOptions{
+		{Format: OutputTable, TimeFormat: "unix"},
+		{Format: OutputJSON, TimeFormat: "unix"},
+		{Template: "{{.Name}}", TimeFormat: "unix"},
+	} {
+		_, err := NewBufferRenderer(opts)
+		assert.EqualError(t, err, `unknown time format "unix"`)
+	}
+}
+
+func Test_TimestampJSON(t *testing.T) {
+	withTestClock(t)
+	for _, mode := range []string{TimeLocal, TimeUTC, TimeRelative} {
+		buf, err := NewBufferRenderer(RenderOptions{Format: OutputJSON, TimeFormat: mode})
+		require.NoError(t, err)
+		require.NoError(t, buf.Render(testTaskResult))
+		expected := "{\n" +
+			"  \"name\": \"firmware-update\",\n" +
+			"  \"started\": \"2025-03-14T11:56:40Z\",\n" +
+			"  \"finished\": null,\n" +
+			"  \"took\": 90400\n" +
+			"}\n"
+		assert.Equal(t, expected, buf.String(), mode)
+	}
+}
+
+func Test_BMCTimestamp(t *testing.T) {
+	bmcTime := time.Date(2025, 3, 14, 12, 5, 0, 0, time.FixedZone("EDT", -4*3600))
+	ts := BMCTimestamp(bmcTime, 5*time.Minute)
+	b, err := json.Marshal(ts)
+	require.NoError(t, err)
+	assert.Equal(t, `"2025-03-14T16:00:00Z"`, string(b))
+}
+
+func Test_relativeTime(t *testing.T) {
+	cases := map[time.Duration]string{
+		0:                              "just now",
+		999 * time.Millisecond:         "just now",
+		5 * time.Second:                "5s ago",
+		3*time.Minute + 59*time.Second: "3m ago",
+		47 * time.Hour:                 "47h ago",
+		72 * time.Hour:                 "3d ago",
+		-5 * time.Second:               "in 5s",
+	}
+	for age, expected := range cases {
+		assert.Equal(t, expected, relativeTime(age), age.String())
+	}
+}
+
+func Test_DurationString(t *testing.T) {
+	assert.Equal(t, "250ms", Duration(250*time.Millisecond+300*time.Microsecond).String())
+	assert.Equal(t, "1m30s", Duration(90*time.Second+400*time.Millisecond).String())
+	assert.Equal(t, "0s", Duration(0).String())
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+// Package i18n translates operator-facing strings such as prompts.
+// Errors and log messages intentionally stay in English.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Key identifies a message in the catalog.
+type Key string
+
+// Keys of the messages in the catalog.
+const (
+	ConfirmPrompt Key = "confirm.prompt" // ConfirmPrompt asks to go ahead with the described action.
+	ConfirmYes    Key = "confirm.yes"    // ConfirmYes lists the accepted answers, separated by commas.
+)
+
+// DefaultLanguage is used for messages without a translation.
+const DefaultLanguage = "en"
+
+var catalog = map[string]map[Key]string{
+	"en": {
+		ConfirmPrompt: "%s\nContinue? [y/N] ",
+		ConfirmYes:    "y,yes",
+	},
+	"de": {
+		ConfirmPrompt: "%s\nFortfahren? [j/N] ",
+		ConfirmYes:    "j,ja,y,yes",
+	},
+}
+
+// Languages returns the supported languages in sorted order.
+func Languages() []string {
+	langs := make([]string, 0, len(catalog))
+	for lang := range catalog {
+		langs = append(langs, lang)
+	}
+	slices.Sort(langs)
+	return langs
+}
+
+// ParseLanguage returns the supported language matching tag, which may be
+// a plain language code or a locale like "de_DE.UTF-8" or "de-AT".
+func ParseLanguage(tag string) (string, error) {
+	lang := strings.ToLower(tag)
+	if i := strings.IndexAny(lang, "_-."); i >= 0 {
+		lang = lang[:i]
+	}
+	if _, ok := catalog[lang]; !ok {
+		return "", fmt.Errorf("unsupported language %q (supported: %s)", tag, strings.Join(Languages(), ", "))
+	}
+	return lang, nil
+}
+
+type languageKey struct{}
+
+// WithLanguage adds the language for operator-facing strings to the context
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// LanguageFromContext retrieves the language from context
+// It falls back to DefaultLanguage.
+func LanguageFromContext(ctx context.Context) string {
+	if lang, ok := ctx.Value(languageKey{}).(string); ok {
+		return lang
+	}
+	return DefaultLanguage
+}
+
+// Sprintf formats the message for key in the language from the context.
+// Messages without a translation fall back to DefaultLanguage, unknown keys
+// are formatted as the key itself so they remain recognizable.
+func Sprintf(ctx context.Context, key Key, args ...any) string {
+	format, ok := catalog[LanguageFromContext(ctx)][key]
+	if !ok {
+		format, ok = catalog[DefaultLanguage][key]
+	}
+	if !ok {
+		format = string(key)
+	}
+	return fmt.Sprintf(format, args...)
+}
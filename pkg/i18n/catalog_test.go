@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Sprintf(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "Reset node01.\nContinue? [y/N] ", Sprintf(ctx, ConfirmPrompt, "Reset node01."))
+	ctx = WithLanguage(ctx, "de")
+	assert.Equal(t, "Reset node01.\nFortfahren? [j/N] ", Sprintf(ctx, ConfirmPrompt, "Reset node01."))
+}
+
+func Test_SprintfFallback(t *testing.T) {
+	const key Key = "test.only"
+	catalog[DefaultLanguage][key] = "only in %s"
+	defer delete(catalog[DefaultLanguage], key)
+
+	ctx := WithLanguage(context.Background(), "de")
+	assert.Equal(t, "only in English", Sprintf(ctx, key, "English"))
+	assert.Equal(t, "missing.key", Sprintf(ctx, "missing.key"))
+}
+
+func Test_TranslationsHaveDefault(t *testing.T) {
+	for lang, messages := range catalog {
+		for key := range messages {
+			assert.Contains(t, catalog[DefaultLanguage], key, "%s message %q has no default", lang, key)
+		}
+	}
+}
+
+func Test_ParseLanguage(t *testing.T) {
+	for _, tag := range []string{"de", "DE", "de_DE.UTF-8", "de-AT"} {
+		lang, err := ParseLanguage(tag)
+		require.NoError(t, err)
+		assert.Equal(t, "de", lang)
+	}
+	_, err := ParseLanguage("fr")
+	assert.EqualError(t, err, `unsupported language "fr" (supported: de, en)`)
+}
+
+func Test_LanguageFromContextDefault(t *testing.T) {
+	assert.Equal(t, DefaultLanguage, LanguageFromContext(context.Background()))
+}
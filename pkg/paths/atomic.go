@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// AtomicFile is written to a temporary file next to its final name and
+// only renamed into place on Commit, so readers observe either the previous
+// content or the complete new content, never a partial write.
+type AtomicFile struct {
+	*os.File
+	name string
+	perm os.FileMode
+	done bool
+}
+
+// CreateAtomic starts an atomic write of the named file.
+// Either Commit or Abort must be called to release the temporary file.
+func CreateAtomic(name string, perm os.FileMode) (*AtomicFile, error) {
+	dir, base := filepath.Split(name)
+	if dir == "" {
+		dir = "."
+	}
+	f, err := os.CreateTemp(dir, "."+base+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicFile{File: f, name: name, perm: perm}, nil
+}
+
+// Commit flushes the written data to stable storage and renames the
+// temporary file over the target.
+func (f *AtomicFile) Commit() error {
+	if f.done {
+		return errors.New("atomic write of " + f.name + " already finished")
+	}
+	f.done = true
+	err := f.Sync()
+	if err == nil {
+		err = f.Chmod(f.perm)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.File.Name(), f.name)
+	}
+	if err != nil {
+		_ = os.Remove(f.File.Name())
+		return err
+	}
+	syncDir(filepath.Dir(f.name))
+	return nil
+}
+
+// Abort discards the temporary file and leaves the target untouched.
+// Calling Abort after Commit is a no-op, so it can be deferred.
+func (f *AtomicFile) Abort() error {
+	if f.done {
+		return nil
+	}
+	f.done = true
+	_ = f.Close()
+	return os.Remove(f.File.Name())
+}
+
+// WriteFileAtomic is like os.WriteFile but replaces the file atomically.
+func WriteFileAtomic(name string, data []byte, perm os.FileMode) error {
+	f, err := CreateAtomic(name, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Abort()
+		return err
+	}
+	return f.Commit()
+}
+
+// syncDir persists the rename in the parent directory. This is best effort,
+// some platforms and file systems do not support syncing directories.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	_ = d.Sync()
+	_ = d.Close()
+}
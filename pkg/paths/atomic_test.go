@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "baseline.json")
+
+	require.NoError(t, WriteFileAtomic(name, []byte("old"), 0o600))
+	require.NoError(t, WriteFileAtomic(name, []byte("new"), 0o640))
+
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	info, err := os.Stat(name)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary file left behind")
+}
+
+func Test_AtomicFile_Abort(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "baseline.json")
+	require.NoError(t, WriteFileAtomic(name, []byte("old"), 0o600))
+
+	f, err := CreateAtomic(name, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString("partial")
+	require.NoError(t, err)
+	require.NoError(t, f.Abort())
+	require.NoError(t, f.Abort())
+
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary file left behind")
+}
+
+func Test_AtomicFile_CommitTwice(t *testing.T) {
+	f, err := CreateAtomic(filepath.Join(t.TempDir(), "state"), 0o600)
+	require.NoError(t, err)
+	require.NoError(t, f.Commit())
+	assert.Error(t, f.Commit())
+	assert.NoError(t, f.Abort())
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const appName = "bmctl"
+
+// ConfigDir returns the directory for bmctl configuration files.
+// It follows the platform conventions of os.UserConfigDir, e.g.
+// $XDG_CONFIG_HOME/bmctl on Linux, %AppData%\bmctl on Windows and
+// ~/Library/Application Support/bmctl on macOS.
+func ConfigDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, appName), nil
+}
+
+// CacheDir returns the directory for bmctl cache files.
+// It follows the platform conventions of os.UserCacheDir, e.g.
+// $XDG_CACHE_HOME/bmctl on Linux, %LocalAppData%\bmctl on Windows and
+// ~/Library/Caches/bmctl on macOS.
+func CacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, appName), nil
+}
+
+// StateDir returns the directory for bmctl state that should persist
+// between invocations but is not configuration, e.g. resumable downloads.
+// It is $XDG_STATE_HOME/bmctl (default ~/.local/state/bmctl) on Unix,
+// %LocalAppData%\bmctl\State on Windows and
+// ~/Library/Application Support/bmctl/State on macOS.
+func StateDir() (string, error) {
+	switch runtime.GOOS {
+	case "windows":
+		dir := os.Getenv("LocalAppData")
+		if dir == "" {
+			return "", errors.New("%LocalAppData% is not defined")
+		}
+		return filepath.Join(dir, appName, "State"), nil
+	case "darwin", "ios":
+		dir, err := ConfigDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(dir, "State"), nil
+	}
+
+	// Relative paths in XDG variables are invalid and must be ignored.
+	if dir := os.Getenv("XDG_STATE_HOME"); filepath.IsAbs(dir) {
+		return filepath.Join(dir, appName), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "state", appName), nil
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dirs_XDG(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "darwin" {
+		t.Skip("XDG base directories only apply to Unix")
+	}
+	t.Setenv("XDG_CONFIG_HOME", "/xdg/config")
+	t.Setenv("XDG_CACHE_HOME", "/xdg/cache")
+	t.Setenv("XDG_STATE_HOME", "/xdg/state")
+
+	dir, err := ConfigDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/xdg/config/bmctl", dir)
+
+	dir, err = CacheDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/xdg/cache/bmctl", dir)
+
+	dir, err = StateDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/xdg/state/bmctl", dir)
+}
+
+func Test_StateDir_Default(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "darwin" {
+		t.Skip("XDG base directories only apply to Unix")
+	}
+	t.Setenv("HOME", "/home/operator")
+	for _, value := range []string{"", "relative/state"} {
+		t.Setenv("XDG_STATE_HOME", value)
+		dir, err := StateDir()
+		require.NoError(t, err)
+		assert.Equal(t, "/home/operator/.local/state/bmctl", dir)
+	}
+}
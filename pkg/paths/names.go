@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+)
+
+// UniqueName returns a file name that does not collide with names chosen
+// by concurrent bmctl processes or goroutines, for example
+// "dump-20250612T101500Z-4711-3f9a0c1e.json". The extension may be empty.
+func UniqueName(prefix, ext string) string {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	name := fmt.Sprintf("%s-%s-%d-%s", prefix, stamp, os.Getpid(), hex.EncodeToString(b[:]))
+	if ext != "" {
+		name += "." + ext
+	}
+	return name
+}
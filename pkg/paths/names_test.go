@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UniqueName(t *testing.T) {
+	pattern := regexp.MustCompile(`^dump-[0-9]{8}T[0-9]{6}Z-[0-9]+-[0-9a-f]{8}\.json$`)
+	seen := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		name := UniqueName("dump", "json")
+		assert.Regexp(t, pattern, name)
+		assert.False(t, seen[name], "duplicate name %s", name)
+		seen[name] = true
+	}
+	assert.Regexp(t, regexp.MustCompile(`^cassette-[^.]+$`), UniqueName("cassette", ""))
+}
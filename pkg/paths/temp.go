@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/GSI-HPC/bmctl/pkg/cli"
+)
+
+// TempDir is a private temporary directory for one bmctl invocation.
+type TempDir struct {
+	path       string
+	unregister func()
+	once       sync.Once
+	err        error
+}
+
+// NewTempDir creates a temporary directory only accessible by the current
+// user (mode 0700). Callers must defer Remove.
+// The directory stays in place while a command winds down after an
+// interrupt, so it can still be used during teardown. Only when a repeated
+// interrupt forces the exit it is removed right away, see cli.OnForcedExit.
+// It fails without creating anything if ctx is already done.
+func NewTempDir(ctx context.Context) (*TempDir, error) {
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	path, err := os.MkdirTemp("", appName+"-*")
+	if err != nil {
+		return nil, err
+	}
+	// MkdirTemp already uses 0700, but be explicit about the guarantee.
+	if err := os.Chmod(path, 0o700); err != nil {
+		_ = os.RemoveAll(path)
+		return nil, err
+	}
+	d := &TempDir{path: path}
+	d.unregister = cli.OnForcedExit(func() { _ = os.RemoveAll(path) })
+	return d, nil
+}
+
+// Path returns the absolute path of the directory.
+func (d *TempDir) Path() string {
+	return d.path
+}
+
+// Remove deletes the directory and everything in it.
+// It is safe to call Remove multiple times and concurrently.
+func (d *TempDir) Remove() error {
+	d.once.Do(func() {
+		d.unregister()
+		d.err = os.RemoveAll(d.path)
+	})
+	return d.err
+}
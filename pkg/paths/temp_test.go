@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 GSI Helmholtzzentrum für Schwerionenforschung GmbH <https://www.gsi.de/en/>
+//
+// SPDX-License-Identifier: LGPL-3.0-or-later
+
+package paths
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/GSI-HPC/bmctl/pkg/cli"
+	_testing "github.com/GSI-HPC/bmctl/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewTempDir(t *testing.T) {
+	d, err := NewTempDir(context.Background())
+	require.NoError(t, err)
+	info, err := os.Stat(d.Path())
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	err = os.WriteFile(filepath.Join(d.Path(), "body.json"), []byte("{}"), 0o600)
+	require.NoError(t, err)
+	require.NoError(t, d.Remove())
+	assert.NoDirExists(t, d.Path())
+	assert.NoError(t, d.Remove())
+}
+
+func Test_NewTempDir_KeptOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d, err := NewTempDir(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, d.Remove()) })
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	assert.DirExists(t, d.Path())
+}
+
+func Test_NewTempDir_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cli.ErrInterrupted)
+	d, err := NewTempDir(ctx)
+	assert.ErrorIs(t, err, cli.ErrInterrupted)
+	assert.Nil(t, d)
+}
+
+func Test_NewTempDir_RemovedOnForcedExit(t *testing.T) {
+	if os.Getenv("FORK") == "1" {
+		ctx := cli.SignalContext()
+		d, err := NewTempDir(ctx)
+		require.NoError(t, err)
+		defer func() { _ = d.Remove() }()
+		fmt.Println(d.Path())
+		p, err := os.FindProcess(os.Getpid())
+		require.NoError(t, err)
+		require.NoError(t, p.Signal(syscall.SIGINT))
+		<-ctx.Done()
+		assert.DirExists(t, d.Path())
+		require.NoError(t, p.Signal(syscall.SIGINT))
+		time.Sleep(time.Second)
+		return
+	}
+
+	stdout, _, err := _testing.RunForkTest("Test_NewTempDir_RemovedOnForcedExit")
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, cli.EXIT_FAILURE, exitErr.ExitCode())
+	path := strings.TrimSpace(stdout)
+	require.True(t, filepath.IsAbs(path), stdout)
+	assert.NoDirExists(t, path)
+}